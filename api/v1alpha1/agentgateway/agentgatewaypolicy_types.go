@@ -0,0 +1,263 @@
+// Package agentgateway contains the v1alpha1 API types for policies that attach to Gateway API
+// resources served by the agentgateway data plane.
+package agentgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// Condition types reported on AgentgatewayPolicy.status.conditions.
+const (
+	// AgentgatewayPolicyConditionAccepted reports whether the policy itself is well-formed and
+	// its targetRef and any referenced backends (e.g. an external ratelimit service) resolve,
+	// independent of whether the data plane has picked up the resulting config yet.
+	AgentgatewayPolicyConditionAccepted = "Accepted"
+	// AgentgatewayPolicyConditionEnforced reports whether an Accepted policy has actually been
+	// pushed to and is being enforced by the data plane.
+	AgentgatewayPolicyConditionEnforced = "Enforced"
+)
+
+// Reasons for AgentgatewayPolicyConditionAccepted.
+const (
+	PolicyReasonAccepted       = "Accepted"
+	PolicyReasonInvalid        = "Invalid"
+	PolicyReasonTargetNotFound = "TargetNotFound"
+	PolicyReasonConflicted     = "Conflicted"
+)
+
+// Reasons for AgentgatewayPolicyConditionEnforced.
+const (
+	PolicyReasonEnforced   = "Enforced"
+	PolicyReasonUnknown    = "Unknown"
+	PolicyReasonOverridden = "Overridden"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories=kgateway
+
+// AgentgatewayPolicy attaches rate-limiting (and related) behavior to a Gateway API resource
+// served by agentgateway.
+type AgentgatewayPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentgatewayPolicySpec   `json:"spec,omitempty"`
+	Status AgentgatewayPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AgentgatewayPolicyList contains a list of AgentgatewayPolicy.
+type AgentgatewayPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentgatewayPolicy `json:"items"`
+}
+
+// AgentgatewayPolicySpec defines the desired policy configuration.
+type AgentgatewayPolicySpec struct {
+	// TargetRef identifies the Gateway API resource (Gateway or HTTPRoute) this policy attaches to.
+	TargetRef gwv1alpha2.NamespacedPolicyTargetReference `json:"targetRef"`
+
+	// Rules are the rate-limit rules this policy directly contributes.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+
+	// Defaults supplies fallback rules for the target: rules a target doesn't otherwise have.
+	// Typically attached at the Gateway level to seed every route with a baseline limit.
+	// +optional
+	Defaults *PolicyBlock `json:"defaults,omitempty"`
+
+	// Overrides forcibly applies rules to the target, taking precedence over its own rules.
+	// Typically attached at the Gateway level to enforce a limit regardless of per-route config.
+	// +optional
+	Overrides *PolicyBlock `json:"overrides,omitempty"`
+
+	// ResponseHeaders opts into emitting RateLimit-* response headers.
+	// +optional
+	ResponseHeaders *ResponseHeadersConfig `json:"responseHeaders,omitempty"`
+
+	// Algorithm selects how requests are counted against a rule's limit over time.
+	// Defaults to AlgorithmFixedWindow.
+	// +optional
+	// +kubebuilder:validation:Enum=fixedWindow;slidingWindow
+	Algorithm Algorithm `json:"algorithm,omitempty"`
+
+	// EnforcementMode controls whether an over-limit decision actually rejects the request.
+	// Defaults to EnforcementModeEnforce.
+	// +optional
+	// +kubebuilder:validation:Enum=enforce;shadow
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+}
+
+// EnforcementMode controls whether a rate-limit decision is actually enforced on the data path.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce rejects over-limit requests with a 429, as usual.
+	EnforcementModeEnforce EnforcementMode = "enforce"
+	// EnforcementModeShadow records what the decision would have been (via a response header
+	// and metric) but never rejects the request, letting a new limit be rolled out safely.
+	EnforcementModeShadow EnforcementMode = "shadow"
+)
+
+// Algorithm selects a rate-limit counting strategy.
+type Algorithm string
+
+const (
+	// AlgorithmFixedWindow counts requests in clock-aligned windows (e.g. per-minute resets at
+	// :00), resetting the counter abruptly at each window boundary.
+	AlgorithmFixedWindow Algorithm = "fixedWindow"
+	// AlgorithmSlidingWindow blends the previous and current window's counts, weighted by how
+	// far into the current window the request landed, avoiding the abrupt reset at a boundary.
+	AlgorithmSlidingWindow Algorithm = "slidingWindow"
+)
+
+// ResponseHeadersConfig controls emission of IETF draft RateLimit-* response headers.
+type ResponseHeadersConfig struct {
+	// Enabled marks the policy as opting into RateLimit-Limit/Remaining/Reset (and Retry-After on
+	// 429). ratelimit.Enforce does not read it: it attaches those headers to every decision.
+	Enabled bool `json:"enabled"`
+
+	// QuotaPolicy is a policy identifier for the RateLimit-Policy header. No RateLimit-Policy
+	// header is emitted: ratelimit.ResponseHeaders does not read this field.
+	// +optional
+	QuotaPolicy string `json:"quotaPolicy,omitempty"`
+}
+
+// Strategy controls how a PolicyBlock's rules compose with a target's own rules.
+// +kubebuilder:validation:Enum=atomic;merge
+type Strategy string
+
+const (
+	// StrategyAtomic treats the whole PolicyBlock as a single unit: a default is ignored
+	// entirely if the target already has any policy attached, and an override wholesale
+	// replaces the target's rules.
+	StrategyAtomic Strategy = "atomic"
+	// StrategyMerge operates rule-by-rule, keyed by rule name: a default contributes only
+	// rules whose names are absent on the target, and an override replaces rules with matching
+	// names while adding any new ones.
+	StrategyMerge Strategy = "merge"
+)
+
+// PolicyBlock is a set of rules plus the strategy used to compose them onto a target.
+type PolicyBlock struct {
+	Strategy Strategy `json:"strategy"`
+	Rules    []Rule   `json:"rules"`
+}
+
+// AgentgatewayPolicyStatus reports the observed state of an AgentgatewayPolicy.
+type AgentgatewayPolicyStatus struct {
+	// Conditions report Accepted and Enforced state. See AgentgatewayPolicyConditionAccepted
+	// and AgentgatewayPolicyConditionEnforced.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// RuleOrigins reports, for each rule name in the effective (post defaults/overrides) rule
+	// set, which policy contributed it and whether it was defaulted or overridden. This covers
+	// rules contributed by other Gateway-scoped policies, not just this policy's own Rules.
+	// +optional
+	RuleOrigins []RuleOriginStatus `json:"ruleOrigins,omitempty"`
+}
+
+// RuleOriginStatus reports which policy contributed a single rule in the effective rule set.
+type RuleOriginStatus struct {
+	// RuleName is the name of the rule this origin describes.
+	RuleName string `json:"ruleName"`
+
+	// PolicyName is the name of the AgentgatewayPolicy that contributed the rule.
+	PolicyName string `json:"policyName"`
+
+	// Defaulted is true if the rule came from a Defaults block rather than the target's own
+	// policy.
+	// +optional
+	Defaulted bool `json:"defaulted,omitempty"`
+
+	// Overridden is true if the rule came from an Overrides block, whether it replaced a
+	// same-named rule the target already had or was added as a new rule.
+	// +optional
+	Overridden bool `json:"overridden,omitempty"`
+}
+
+// Rule is a single named rate-limit rule. The rule name is used to key defaults/overrides
+// composition (see AgentgatewayPolicySpec.Defaults/Overrides) and to report per-rule origin.
+type Rule struct {
+	// Name identifies this rule within the policy.
+	Name string `json:"name"`
+
+	// Descriptors are matched, in order, against each request to build the descriptor tuple
+	// sent to the external ratelimit service.
+	Descriptors []Descriptor `json:"descriptors"`
+
+	// Limit is the rate limit applied to the descriptor tuple this rule produces.
+	Limit Limit `json:"limit"`
+}
+
+// Limit is a requests-per-unit-time rate limit.
+type Limit struct {
+	RequestsPerUnit uint32 `json:"requestsPerUnit"`
+	// Unit is one of "second", "minute", "hour", "day".
+	Unit string `json:"unit"`
+}
+
+// Descriptor configures a single entry of a rule's descriptor tuple.
+type Descriptor struct {
+	// RemoteAddress contributes the client IP to the descriptor tuple.
+	// +optional
+	RemoteAddress *RemoteAddressDescriptor `json:"remoteAddress,omitempty"`
+
+	// RequestHeader contributes the value of the named request header.
+	// +optional
+	RequestHeader *RequestHeaderDescriptor `json:"requestHeader,omitempty"`
+
+	// PathMatch gates this descriptor entry on whether the request path matches, contributing
+	// a static value rather than the literal request path.
+	// +optional
+	PathMatch *PathMatchDescriptor `json:"pathMatch,omitempty"`
+
+	// GenericKey contributes a fixed, configured value, independent of the request.
+	// +optional
+	GenericKey *GenericKeyDescriptor `json:"genericKey,omitempty"`
+
+	// Metadata contributes a value read from dynamic request metadata.
+	// +optional
+	Metadata *MetadataDescriptor `json:"metadata,omitempty"`
+
+	// SkipIfAbsent controls whether the whole rule is skipped (true, the default) or the entry
+	// is simply omitted from the descriptor tuple (false) when this entry's source value (a
+	// header, metadata key, etc.) isn't present on the request.
+	// +optional
+	SkipIfAbsent *bool `json:"skipIfAbsent,omitempty"`
+}
+
+// GenericKeyDescriptor contributes a fixed, configured value.
+type GenericKeyDescriptor struct {
+	Value string `json:"value"`
+}
+
+// MetadataDescriptor contributes a value read from dynamic request metadata.
+type MetadataDescriptor struct {
+	// Source names the metadata namespace the key is read from (e.g. the filter that wrote it).
+	Source string `json:"source"`
+	Key    string `json:"key"`
+}
+
+// RemoteAddressDescriptor has no fields; its presence contributes the client IP.
+type RemoteAddressDescriptor struct{}
+
+// RequestHeaderDescriptor contributes the value of the named request header.
+type RequestHeaderDescriptor struct {
+	Name string `json:"name"`
+}
+
+// PathMatchDescriptor contributes a static value when the request path matches.
+type PathMatchDescriptor struct {
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// +optional
+	Exact string `json:"exact,omitempty"`
+	// +optional
+	Regex string `json:"regex,omitempty"`
+}
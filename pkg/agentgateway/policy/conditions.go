@@ -0,0 +1,123 @@
+// Package policy implements the AgentgatewayPolicy reconciliation logic: status reporting,
+// defaults/overrides composition, and descriptor translation.
+package policy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+// TargetResolution describes the outcome of validating a policy's targetRef and any backends
+// (e.g. an external ratelimit service) it references.
+type TargetResolution int
+
+const (
+	// TargetResolved means the targetRef and all referenced backends resolved cleanly.
+	TargetResolved TargetResolution = iota
+	// TargetNotFound means the targetRef does not refer to an existing resource.
+	TargetNotFound
+	// TargetInvalid means the policy spec itself is malformed (e.g. a targetRef kind other than
+	// Gateway or HTTPRoute).
+	TargetInvalid
+	// TargetConflicted means another policy already attaches to the same target in a way this
+	// one cannot compose with (e.g. two atomic policies on the same target).
+	TargetConflicted
+)
+
+// EnforcementState describes whether an Accepted policy's config has reached the data plane.
+type EnforcementState int
+
+const (
+	// EnforcementUnknown means the controller hasn't yet confirmed the data plane picked up
+	// the config (e.g. the push is still in flight).
+	EnforcementUnknown EnforcementState = iota
+	// EnforcementEnforced means the data plane has confirmed the config is active.
+	EnforcementEnforced
+	// EnforcementOverridden means the rules this policy contributed were entirely superseded by
+	// another policy's override (atomic, or merge replacing every one of them by name), so
+	// nothing of this policy reached the data plane.
+	EnforcementOverridden
+)
+
+// BuildConditions computes the Accepted and Enforced status conditions for an AgentgatewayPolicy
+// observed at generation, given the outcome of target/backend resolution and, if accepted, the
+// resulting data-plane enforcement state.
+func BuildConditions(generation int64, target TargetResolution, enforcement EnforcementState) []metav1.Condition {
+	accepted := acceptedCondition(generation, target)
+	conditions := []metav1.Condition{accepted}
+
+	if accepted.Status != metav1.ConditionTrue {
+		// An unaccepted policy can't be enforced; don't report a misleading Enforced condition.
+		return conditions
+	}
+
+	conditions = append(conditions, enforcedCondition(generation, enforcement))
+	return conditions
+}
+
+func acceptedCondition(generation int64, target TargetResolution) metav1.Condition {
+	switch target {
+	case TargetResolved:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionAccepted,
+			Status:             metav1.ConditionTrue,
+			Reason:             agentgateway.PolicyReasonAccepted,
+			ObservedGeneration: generation,
+			Message:            "targetRef and referenced backends resolved",
+		}
+	case TargetNotFound:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionAccepted,
+			Status:             metav1.ConditionFalse,
+			Reason:             agentgateway.PolicyReasonTargetNotFound,
+			ObservedGeneration: generation,
+			Message:            "targetRef does not refer to an existing resource",
+		}
+	case TargetConflicted:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionAccepted,
+			Status:             metav1.ConditionFalse,
+			Reason:             agentgateway.PolicyReasonConflicted,
+			ObservedGeneration: generation,
+			Message:            "another policy on this target cannot be composed with this one",
+		}
+	default:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionAccepted,
+			Status:             metav1.ConditionFalse,
+			Reason:             agentgateway.PolicyReasonInvalid,
+			ObservedGeneration: generation,
+			Message:            "policy spec is invalid",
+		}
+	}
+}
+
+func enforcedCondition(generation int64, enforcement EnforcementState) metav1.Condition {
+	switch enforcement {
+	case EnforcementEnforced:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionEnforced,
+			Status:             metav1.ConditionTrue,
+			Reason:             agentgateway.PolicyReasonEnforced,
+			ObservedGeneration: generation,
+			Message:            "config has been pushed to and confirmed by the data plane",
+		}
+	case EnforcementOverridden:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionEnforced,
+			Status:             metav1.ConditionFalse,
+			Reason:             agentgateway.PolicyReasonOverridden,
+			ObservedGeneration: generation,
+			Message:            "rules were superseded by another policy's override on this target",
+		}
+	default:
+		return metav1.Condition{
+			Type:               agentgateway.AgentgatewayPolicyConditionEnforced,
+			Status:             metav1.ConditionFalse,
+			Reason:             agentgateway.PolicyReasonUnknown,
+			ObservedGeneration: generation,
+			Message:            "waiting for the data plane to confirm the pushed config",
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+func TestBuildConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		target          TargetResolution
+		enforcement     EnforcementState
+		wantAccepted    metav1.ConditionStatus
+		wantReason      string
+		wantEnforcedLen int
+	}{
+		{
+			name:            "resolved and enforced",
+			target:          TargetResolved,
+			enforcement:     EnforcementEnforced,
+			wantAccepted:    metav1.ConditionTrue,
+			wantReason:      agentgateway.PolicyReasonAccepted,
+			wantEnforcedLen: 2,
+		},
+		{
+			name:            "target not found never reports enforced",
+			target:          TargetNotFound,
+			enforcement:     EnforcementEnforced,
+			wantAccepted:    metav1.ConditionFalse,
+			wantReason:      agentgateway.PolicyReasonTargetNotFound,
+			wantEnforcedLen: 1,
+		},
+		{
+			name:            "accepted but not yet enforced",
+			target:          TargetResolved,
+			enforcement:     EnforcementUnknown,
+			wantAccepted:    metav1.ConditionTrue,
+			wantReason:      agentgateway.PolicyReasonAccepted,
+			wantEnforcedLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := BuildConditions(1, tt.target, tt.enforcement)
+			assert.Len(t, conditions, tt.wantEnforcedLen)
+			assert.Equal(t, agentgateway.AgentgatewayPolicyConditionAccepted, conditions[0].Type)
+			assert.Equal(t, tt.wantAccepted, conditions[0].Status)
+			assert.Equal(t, tt.wantReason, conditions[0].Reason)
+		})
+	}
+}
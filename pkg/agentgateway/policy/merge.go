@@ -0,0 +1,145 @@
+package policy
+
+import (
+	"sort"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+// RuleOrigin identifies which policy contributed a given rule in a composed rule set, so status
+// can report which rules were defaulted or overridden.
+type RuleOrigin struct {
+	// PolicyName is the name of the policy that contributed the rule.
+	PolicyName string
+	// Defaulted is true if the rule came from a PolicyBlock with StrategyAtomic/StrategyMerge
+	// defaults rather than the target's own policy.
+	Defaulted bool
+	// Overridden is true if the rule came from an overrides PolicyBlock, whether it replaced a
+	// same-named rule the target already had or was added as a new rule.
+	Overridden bool
+}
+
+// ToRuleOriginStatus converts the map returned by Compose into the status.ruleOrigins list,
+// sorted by rule name for a stable status diff.
+func ToRuleOriginStatus(origins map[string]RuleOrigin) []agentgateway.RuleOriginStatus {
+	names := make([]string, 0, len(origins))
+	for name := range origins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]agentgateway.RuleOriginStatus, 0, len(names))
+	for _, name := range names {
+		origin := origins[name]
+		statuses = append(statuses, agentgateway.RuleOriginStatus{
+			RuleName:   name,
+			PolicyName: origin.PolicyName,
+			Defaulted:  origin.Defaulted,
+			Overridden: origin.Overridden,
+		})
+	}
+	return statuses
+}
+
+// Compose applies a Gateway-scoped defaults block and then an overrides block onto a target's
+// own rules, returning the effective rule set and the origin of each rule by name.
+//
+// hasOwnPolicy reports whether the target has its own AgentgatewayPolicy attached at all, which
+// may still be true even when that policy contributes zero rules (ownRules empty) — an atomic
+// default must not apply in that case, since the target isn't policy-less, just rule-less.
+func Compose(ownPolicyName string, ownRules []agentgateway.Rule, hasOwnPolicy bool, defaultsPolicyName string, defaults *agentgateway.PolicyBlock, overridesPolicyName string, overrides *agentgateway.PolicyBlock) ([]agentgateway.Rule, map[string]RuleOrigin) {
+	rules := make(map[string]agentgateway.Rule, len(ownRules))
+	origins := make(map[string]RuleOrigin, len(ownRules))
+	order := make([]string, 0, len(ownRules))
+
+	for _, r := range ownRules {
+		rules[r.Name] = r
+		origins[r.Name] = RuleOrigin{PolicyName: ownPolicyName}
+		order = append(order, r.Name)
+	}
+
+	if defaults != nil {
+		order = applyDefaults(defaultsPolicyName, defaults, hasOwnPolicy, rules, origins, order)
+	}
+
+	if overrides != nil {
+		order = applyOverrides(overridesPolicyName, overrides, rules, origins, order)
+	}
+
+	result := make([]agentgateway.Rule, 0, len(order))
+	for _, name := range order {
+		result = append(result, rules[name])
+	}
+	return result, origins
+}
+
+func applyDefaults(policyName string, defaults *agentgateway.PolicyBlock, hasOwnPolicy bool, rules map[string]agentgateway.Rule, origins map[string]RuleOrigin, order []string) []string {
+	switch defaults.Strategy {
+	case agentgateway.StrategyAtomic:
+		// An atomic default is ignored entirely once the target has any policy of its own.
+		if hasOwnPolicy {
+			return order
+		}
+		for _, r := range defaults.Rules {
+			if _, exists := rules[r.Name]; !exists {
+				order = append(order, r.Name)
+			}
+			rules[r.Name] = r
+			origins[r.Name] = RuleOrigin{PolicyName: policyName, Defaulted: true}
+		}
+		return order
+	case agentgateway.StrategyMerge:
+		// A merge default only fills in rules whose names are absent on the target.
+		for _, r := range defaults.Rules {
+			if _, exists := rules[r.Name]; exists {
+				continue
+			}
+			rules[r.Name] = r
+			origins[r.Name] = RuleOrigin{PolicyName: policyName, Defaulted: true}
+			order = append(order, r.Name)
+		}
+		return order
+	default:
+		return order
+	}
+}
+
+func applyOverrides(policyName string, overrides *agentgateway.PolicyBlock, rules map[string]agentgateway.Rule, origins map[string]RuleOrigin, order []string) []string {
+	switch overrides.Strategy {
+	case agentgateway.StrategyAtomic:
+		// An atomic override wholesale replaces the target's rules.
+		newOrder := make([]string, 0, len(overrides.Rules))
+		newRules := make(map[string]agentgateway.Rule, len(overrides.Rules))
+		newOrigins := make(map[string]RuleOrigin, len(overrides.Rules))
+		for _, r := range overrides.Rules {
+			newRules[r.Name] = r
+			newOrigins[r.Name] = RuleOrigin{PolicyName: policyName, Overridden: true}
+			newOrder = append(newOrder, r.Name)
+		}
+		for k := range rules {
+			delete(rules, k)
+		}
+		for k, v := range newRules {
+			rules[k] = v
+		}
+		for k := range origins {
+			delete(origins, k)
+		}
+		for k, v := range newOrigins {
+			origins[k] = v
+		}
+		return newOrder
+	case agentgateway.StrategyMerge:
+		// A merge override replaces rules with matching names and adds any new ones.
+		for _, r := range overrides.Rules {
+			if _, exists := rules[r.Name]; !exists {
+				order = append(order, r.Name)
+			}
+			rules[r.Name] = r
+			origins[r.Name] = RuleOrigin{PolicyName: policyName, Overridden: true}
+		}
+		return order
+	default:
+		return order
+	}
+}
@@ -0,0 +1,122 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+func rule(name string) agentgateway.Rule {
+	return agentgateway.Rule{Name: name, Limit: agentgateway.Limit{RequestsPerUnit: 1, Unit: "minute"}}
+}
+
+func TestComposeMergeDefaultFillsMissingRuleOnly(t *testing.T) {
+	own := []agentgateway.Rule{rule("path-limit")}
+	defaults := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyMerge,
+		Rules:    []agentgateway.Rule{rule("default-ip-limit")},
+	}
+
+	result, origins := Compose("route-policy", own, true, "gateway-default", defaults, "", nil)
+
+	assert.ElementsMatch(t, []string{"path-limit", "default-ip-limit"}, ruleNames(result))
+	assert.False(t, origins["path-limit"].Defaulted)
+	assert.True(t, origins["default-ip-limit"].Defaulted)
+	assert.Equal(t, "gateway-default", origins["default-ip-limit"].PolicyName)
+}
+
+func TestComposeAtomicDefaultIgnoredWhenTargetHasOwnPolicy(t *testing.T) {
+	own := []agentgateway.Rule{rule("path-limit")}
+	defaults := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyAtomic,
+		Rules:    []agentgateway.Rule{rule("default-ip-limit")},
+	}
+
+	result, _ := Compose("route-policy", own, true, "gateway-default", defaults, "", nil)
+
+	assert.Equal(t, []string{"path-limit"}, ruleNames(result))
+}
+
+func TestComposeAtomicDefaultAppliesWhenTargetHasNoPolicy(t *testing.T) {
+	defaults := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyAtomic,
+		Rules:    []agentgateway.Rule{rule("default-ip-limit")},
+	}
+
+	result, origins := Compose("", nil, false, "gateway-default", defaults, "", nil)
+
+	assert.Equal(t, []string{"default-ip-limit"}, ruleNames(result))
+	assert.True(t, origins["default-ip-limit"].Defaulted)
+}
+
+func TestComposeAtomicDefaultIgnoredWhenTargetPolicyHasZeroRules(t *testing.T) {
+	// The target has its own AgentgatewayPolicy attached, but that policy happens to contribute
+	// no rules of its own (e.g. it only sets EnforcementMode). hasOwnPolicy must still be true,
+	// since that's a property of attachment, not of len(ownRules) — otherwise this is
+	// indistinguishable from a target with no policy at all, and an atomic default would wrongly
+	// apply.
+	defaults := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyAtomic,
+		Rules:    []agentgateway.Rule{rule("default-ip-limit")},
+	}
+
+	result, _ := Compose("route-policy", nil, true, "gateway-default", defaults, "", nil)
+
+	assert.Empty(t, result)
+}
+
+func TestComposeMergeOverrideReplacesMatchingRuleAndAddsNew(t *testing.T) {
+	own := []agentgateway.Rule{rule("ip-limit"), rule("path-limit")}
+	overrides := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyMerge,
+		Rules: []agentgateway.Rule{
+			{Name: "ip-limit", Limit: agentgateway.Limit{RequestsPerUnit: 99, Unit: "minute"}},
+			rule("new-limit"),
+		},
+	}
+
+	result, origins := Compose("route-policy", own, true, "", nil, "gateway-override", overrides)
+
+	assert.ElementsMatch(t, []string{"ip-limit", "path-limit", "new-limit"}, ruleNames(result))
+	for _, r := range result {
+		if r.Name == "ip-limit" {
+			assert.Equal(t, uint32(99), r.Limit.RequestsPerUnit)
+		}
+	}
+	assert.True(t, origins["ip-limit"].Overridden)
+	assert.False(t, origins["path-limit"].Overridden)
+}
+
+func TestComposeAtomicOverrideReplacesEverything(t *testing.T) {
+	own := []agentgateway.Rule{rule("ip-limit"), rule("path-limit")}
+	overrides := &agentgateway.PolicyBlock{
+		Strategy: agentgateway.StrategyAtomic,
+		Rules:    []agentgateway.Rule{rule("override-limit")},
+	}
+
+	result, origins := Compose("route-policy", own, true, "", nil, "gateway-override", overrides)
+
+	assert.Equal(t, []string{"override-limit"}, ruleNames(result))
+	assert.True(t, origins["override-limit"].Overridden)
+	_, stillPresent := origins["ip-limit"]
+	assert.False(t, stillPresent)
+}
+
+func TestToRuleOriginStatusIsSortedByRuleName(t *testing.T) {
+	origins := map[string]RuleOrigin{
+		"path-limit": {PolicyName: "route-policy"},
+		"ip-limit":   {PolicyName: "gateway-default", Defaulted: true},
+	}
+
+	statuses := ToRuleOriginStatus(origins)
+
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "ip-limit", statuses[0].RuleName)
+	assert.Equal(t, "gateway-default", statuses[0].PolicyName)
+	assert.True(t, statuses[0].Defaulted)
+	assert.Equal(t, "path-limit", statuses[1].RuleName)
+	assert.Equal(t, "route-policy", statuses[1].PolicyName)
+}
@@ -0,0 +1,162 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+// DataPlanePusher pushes a policy's composed rate-limit rules to the agentgateway data plane and
+// reports whether the push has been confirmed.
+type DataPlanePusher interface {
+	Push(ctx context.Context, policy *agentgateway.AgentgatewayPolicy, rules []agentgateway.Rule) (EnforcementState, error)
+}
+
+// Reconciler reconciles AgentgatewayPolicy objects: it resolves the targetRef, composes any
+// Gateway-scoped defaults/overrides onto the target's own rules, pushes the result to the data
+// plane, and reports Accepted/Enforced status on the policy.
+type Reconciler struct {
+	Client client.Client
+	Pusher DataPlanePusher
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &agentgateway.AgentgatewayPolicy{}
+	if err := r.Client.Get(ctx, req.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("getting AgentgatewayPolicy %s: %w", req.NamespacedName, err)
+	}
+
+	target, err := r.resolveTarget(ctx, policy)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("resolving targetRef for AgentgatewayPolicy %s: %w", req.NamespacedName, err)
+	}
+
+	var enforcement EnforcementState
+	var origins map[string]RuleOrigin
+	if target == TargetResolved {
+		var rules []agentgateway.Rule
+		rules, origins, err = r.compose(ctx, policy)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("composing rules for AgentgatewayPolicy %s: %w", req.NamespacedName, err)
+		}
+
+		enforcement = DeriveEnforcementState(policy.Name, ruleNames(policy.Spec.Rules), origins)
+		if enforcement != EnforcementOverridden {
+			enforcement, err = r.Pusher.Push(ctx, policy, rules)
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("pushing rules for AgentgatewayPolicy %s: %w", req.NamespacedName, err)
+			}
+		}
+	}
+
+	policy.Status.Conditions = BuildConditions(policy.Generation, target, enforcement)
+	policy.Status.RuleOrigins = ToRuleOriginStatus(origins)
+
+	if err := r.Client.Status().Update(ctx, policy); err != nil {
+		return ctrl.Result{}, fmt.Errorf("updating status for AgentgatewayPolicy %s: %w", req.NamespacedName, err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// resolveTarget validates that policy's targetRef refers to a Gateway or HTTPRoute that actually
+// exists in the policy's namespace.
+func (r *Reconciler) resolveTarget(ctx context.Context, policy *agentgateway.AgentgatewayPolicy) (TargetResolution, error) {
+	ref := policy.Spec.TargetRef
+
+	var obj client.Object
+	switch string(ref.Kind) {
+	case "Gateway":
+		obj = &gwv1.Gateway{}
+	case "HTTPRoute":
+		obj = &gwv1.HTTPRoute{}
+	default:
+		return TargetInvalid, nil
+	}
+
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+	if err := r.Client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return TargetNotFound, nil
+		}
+		return TargetInvalid, err
+	}
+	return TargetResolved, nil
+}
+
+// compose finds any other AgentgatewayPolicy in the same namespace that carries a Defaults or
+// Overrides block and applies it onto policy's own rules via Compose.
+//
+// Resolving whether a Gateway-scoped policy's target is actually the Gateway that policy's own
+// HTTPRoute is attached to requires walking the route's parentRefs, which this reconciler does
+// not do. It takes the Defaults block and the Overrides block from the other policies in the
+// namespace instead, regardless of their targetRef; when several policies carry one, the last in
+// list order is used and the others are ignored.
+func (r *Reconciler) compose(ctx context.Context, policy *agentgateway.AgentgatewayPolicy) ([]agentgateway.Rule, map[string]RuleOrigin, error) {
+	list := &agentgateway.AgentgatewayPolicyList{}
+	if err := r.Client.List(ctx, list, client.InNamespace(policy.Namespace)); err != nil {
+		return nil, nil, err
+	}
+
+	var defaultsPolicy, overridesPolicy *agentgateway.AgentgatewayPolicy
+	for i := range list.Items {
+		candidate := &list.Items[i]
+		if candidate.Name == policy.Name {
+			continue
+		}
+		if candidate.Spec.Defaults != nil {
+			defaultsPolicy = candidate
+		}
+		if candidate.Spec.Overrides != nil {
+			overridesPolicy = candidate
+		}
+	}
+
+	var defaultsBlock, overridesBlock *agentgateway.PolicyBlock
+	var defaultsName, overridesName string
+	if defaultsPolicy != nil {
+		defaultsBlock = defaultsPolicy.Spec.Defaults
+		defaultsName = defaultsPolicy.Name
+	}
+	if overridesPolicy != nil {
+		overridesBlock = overridesPolicy.Spec.Overrides
+		overridesName = overridesPolicy.Name
+	}
+
+	rules, origins := Compose(policy.Name, policy.Spec.Rules, true, defaultsName, defaultsBlock, overridesName, overridesBlock)
+	return rules, origins, nil
+}
+
+// DeriveEnforcementState reports EnforcementOverridden if every rule the policy contributed
+// under ownRuleNames was either replaced or dropped by another policy's override in origins
+// (i.e. none of them are still attributed to policyName), and EnforcementUnknown otherwise
+// (at least one of its own rules survived, so the policy still needs a data-plane push).
+func DeriveEnforcementState(policyName string, ownRuleNames []string, origins map[string]RuleOrigin) EnforcementState {
+	if len(ownRuleNames) == 0 {
+		return EnforcementUnknown
+	}
+	for _, name := range ownRuleNames {
+		if origin, ok := origins[name]; ok && origin.PolicyName == policyName {
+			return EnforcementUnknown
+		}
+	}
+	return EnforcementOverridden
+}
+
+func ruleNames(rules []agentgateway.Rule) []string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name
+	}
+	return names
+}
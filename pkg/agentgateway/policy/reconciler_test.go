@@ -0,0 +1,152 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, agentgateway.AddToScheme(scheme))
+	require.NoError(t, gwv1.Install(scheme))
+	return scheme
+}
+
+func targetRef(kind, name string) gwv1alpha2.NamespacedPolicyTargetReference {
+	return gwv1alpha2.NamespacedPolicyTargetReference{
+		Group: "gateway.networking.k8s.io",
+		Kind:  gwv1alpha2.Kind(kind),
+		Name:  gwv1alpha2.ObjectName(name),
+	}
+}
+
+// fakePusher reports EnforcementEnforced for every push, recording the rules it was given.
+type fakePusher struct {
+	pushedRules []agentgateway.Rule
+}
+
+func (p *fakePusher) Push(_ context.Context, _ *agentgateway.AgentgatewayPolicy, rules []agentgateway.Rule) (EnforcementState, error) {
+	p.pushedRules = rules
+	return EnforcementEnforced, nil
+}
+
+func TestReconcileAcceptsAndEnforcesWhenTargetExists(t *testing.T) {
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ip-rate-limit", Namespace: "default", Generation: 1},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			TargetRef: targetRef("HTTPRoute", "test-route-1"),
+			Rules:     []agentgateway.Rule{{Name: "ip-limit", Limit: agentgateway.Limit{RequestsPerUnit: 10, Unit: "minute"}}},
+		},
+	}
+	route := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "test-route-1", Namespace: "default"}}
+
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, policy).WithStatusSubresource(policy).Build()
+	pusher := &fakePusher{}
+	r := &Reconciler{Client: c, Pusher: pusher}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}})
+	require.NoError(t, err)
+
+	var got agentgateway.AgentgatewayPolicy
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}, &got))
+
+	accepted := findCondition(got.Status.Conditions, agentgateway.AgentgatewayPolicyConditionAccepted)
+	require.NotNil(t, accepted)
+	assert.Equal(t, metav1.ConditionTrue, accepted.Status)
+
+	enforced := findCondition(got.Status.Conditions, agentgateway.AgentgatewayPolicyConditionEnforced)
+	require.NotNil(t, enforced)
+	assert.Equal(t, metav1.ConditionTrue, enforced.Status)
+
+	require.Len(t, got.Status.RuleOrigins, 1)
+	assert.Equal(t, "ip-limit", got.Status.RuleOrigins[0].RuleName)
+	assert.Equal(t, "ip-rate-limit", got.Status.RuleOrigins[0].PolicyName)
+
+	require.Len(t, pusher.pushedRules, 1)
+	assert.Equal(t, "ip-limit", pusher.pushedRules[0].Name)
+}
+
+func TestReconcileReportsTargetNotFound(t *testing.T) {
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ip-rate-limit", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			TargetRef: targetRef("HTTPRoute", "missing-route"),
+		},
+	}
+
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy).Build()
+	r := &Reconciler{Client: c, Pusher: &fakePusher{}}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}})
+	require.NoError(t, err)
+
+	var got agentgateway.AgentgatewayPolicy
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}, &got))
+
+	accepted := findCondition(got.Status.Conditions, agentgateway.AgentgatewayPolicyConditionAccepted)
+	require.NotNil(t, accepted)
+	assert.Equal(t, metav1.ConditionFalse, accepted.Status)
+	assert.Equal(t, agentgateway.PolicyReasonTargetNotFound, accepted.Reason)
+	assert.Nil(t, findCondition(got.Status.Conditions, agentgateway.AgentgatewayPolicyConditionEnforced))
+}
+
+func TestReconcileReportsOverriddenWhenAnotherPolicyOverridesAllOwnRules(t *testing.T) {
+	own := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ip-rate-limit", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			TargetRef: targetRef("HTTPRoute", "test-route-1"),
+			Rules:     []agentgateway.Rule{{Name: "ip-limit", Limit: agentgateway.Limit{RequestsPerUnit: 10, Unit: "minute"}}},
+		},
+	}
+	gatewayOverride := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway-override", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			TargetRef: targetRef("Gateway", "test-gateway"),
+			Overrides: &agentgateway.PolicyBlock{
+				Strategy: agentgateway.StrategyAtomic,
+				Rules:    []agentgateway.Rule{{Name: "gateway-limit", Limit: agentgateway.Limit{RequestsPerUnit: 5, Unit: "minute"}}},
+			},
+		},
+	}
+	route := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "test-route-1", Namespace: "default"}}
+
+	scheme := newScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, own, gatewayOverride).WithStatusSubresource(own).Build()
+	pusher := &fakePusher{}
+	r := &Reconciler{Client: c, Pusher: pusher}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}})
+	require.NoError(t, err)
+
+	var got agentgateway.AgentgatewayPolicy
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ip-rate-limit"}, &got))
+
+	enforced := findCondition(got.Status.Conditions, agentgateway.AgentgatewayPolicyConditionEnforced)
+	require.NotNil(t, enforced)
+	assert.Equal(t, metav1.ConditionFalse, enforced.Status)
+	assert.Equal(t, agentgateway.PolicyReasonOverridden, enforced.Reason)
+	assert.Nil(t, pusher.pushedRules)
+}
+
+func findCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// WindowCounter tracks request counts for a single descriptor tuple under either a fixed or
+// sliding window, rolling the window forward as time advances.
+type WindowCounter struct {
+	mu sync.Mutex
+
+	limit    uint32
+	duration time.Duration
+	sliding  bool
+
+	windowStart time.Time
+	prev        uint32
+	curr        uint32
+}
+
+// NewWindowCounter returns a counter for a limit of requestsPerUnit over duration, using the
+// sliding-window estimate when sliding is true and plain fixed-window counting otherwise.
+func NewWindowCounter(requestsPerUnit uint32, duration time.Duration, sliding bool, now time.Time) *WindowCounter {
+	return &WindowCounter{
+		limit:       requestsPerUnit,
+		duration:    duration,
+		sliding:     sliding,
+		windowStart: now,
+	}
+}
+
+// Allow rolls the window forward to now if needed, then decides whether a new request at now is
+// allowed, incrementing the current window's count if so.
+func (c *WindowCounter) Allow(now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rollWindow(now)
+
+	if c.sliding {
+		if c.slidingEstimate(now) >= float64(c.limit) {
+			return false
+		}
+		c.curr++
+		return true
+	}
+
+	if c.curr >= c.limit {
+		return false
+	}
+	c.curr++
+	return true
+}
+
+// rollWindow atomically rolls curr into prev and starts a new window once duration has elapsed
+// since windowStart, possibly rolling forward multiple windows if now is far enough ahead.
+func (c *WindowCounter) rollWindow(now time.Time) {
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < c.duration {
+		return
+	}
+
+	windowsElapsed := int64(elapsed / c.duration)
+	c.windowStart = c.windowStart.Add(time.Duration(windowsElapsed) * c.duration)
+
+	if windowsElapsed == 1 {
+		// Exactly one window passed: curr becomes the new prev, as the sliding estimate expects.
+		c.prev = c.curr
+	} else {
+		// More than one window passed with no traffic in between: there's no meaningful prior
+		// window count to carry forward.
+		c.prev = 0
+	}
+	c.curr = 0
+}
+
+// slidingEstimate computes prev * ((D - elapsedInCurrent) / D) + curr, the blended estimate of
+// requests counted against this window from the IETF sliding-window-counter approach.
+func (c *WindowCounter) slidingEstimate(now time.Time) float64 {
+	elapsedInCurrent := now.Sub(c.windowStart)
+	remaining := c.duration - elapsedInCurrent
+	if remaining < 0 {
+		remaining = 0
+	}
+	weight := float64(remaining) / float64(c.duration)
+	return float64(c.prev)*weight + float64(c.curr)
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowCounterFixedWindowResetsAtBoundary(t *testing.T) {
+	start := time.Unix(0, 0)
+	c := NewWindowCounter(1, time.Minute, false, start)
+
+	assert.True(t, c.Allow(start))
+	assert.False(t, c.Allow(start.Add(30*time.Second)))
+
+	// Crossing the boundary resets the counter abruptly, regardless of recent history.
+	assert.True(t, c.Allow(start.Add(61*time.Second)))
+}
+
+func TestWindowCounterSlidingWindowBlendsAcrossBoundary(t *testing.T) {
+	start := time.Unix(0, 0)
+	c := NewWindowCounter(2, time.Minute, true, start)
+
+	// Use up the limit in the first window.
+	assert.True(t, c.Allow(start))
+	assert.True(t, c.Allow(start.Add(10*time.Second)))
+	assert.False(t, c.Allow(start.Add(20*time.Second)))
+
+	// Right at the boundary, the estimate still weighs all of prev's count, so the request is
+	// still rejected instead of getting a fresh fixed-window allowance.
+	justAfter := start.Add(60 * time.Second)
+	assert.False(t, c.Allow(justAfter))
+
+	// Well into the next window, prev's weight has decayed enough to allow a request again.
+	later := start.Add(119 * time.Second)
+	assert.True(t, c.Allow(later))
+}
+
+func TestWindowCounterSlidingEstimateWeighting(t *testing.T) {
+	start := time.Unix(0, 0)
+	c := NewWindowCounter(10, time.Minute, true, start)
+	c.prev = 10
+	c.curr = 0
+
+	// Halfway through the window, prev should contribute half its weight.
+	estimate := c.slidingEstimate(start.Add(30 * time.Second))
+	assert.InDelta(t, 5.0, estimate, 0.001)
+}
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+// DescriptorEntry is a single (key, value) pair sent to the external ratelimit service as part
+// of a descriptor tuple.
+type DescriptorEntry struct {
+	Key   string
+	Value string
+}
+
+// RequestContext carries the per-request values descriptor translation reads from.
+type RequestContext struct {
+	RemoteAddress string
+	Path          string
+	Headers       http.Header
+	// Metadata holds dynamic metadata keyed by "source.key".
+	Metadata map[string]string
+}
+
+// Translate builds the stable descriptor tuple for a rule's descriptor list against a request,
+// in the shape the ratelimit service's ConfigMap already understands (one entry per descriptor,
+// keyed by a fixed, descriptor-type-derived name). ok is false if the rule should be skipped
+// entirely because a required value was absent.
+func Translate(rule agentgateway.Rule, req RequestContext) (tuple []DescriptorEntry, ok bool) {
+	tuple = make([]DescriptorEntry, 0, len(rule.Descriptors))
+
+	for _, d := range rule.Descriptors {
+		entry, present := translateOne(d, req)
+		if present {
+			tuple = append(tuple, entry)
+			continue
+		}
+
+		// Default is to skip the whole rule when a value is missing; skipIfAbsent=false means
+		// just omit this entry from the tuple instead.
+		if d.SkipIfAbsent == nil || *d.SkipIfAbsent {
+			return nil, false
+		}
+	}
+
+	return tuple, true
+}
+
+func translateOne(d agentgateway.Descriptor, req RequestContext) (DescriptorEntry, bool) {
+	switch {
+	case d.RemoteAddress != nil:
+		if req.RemoteAddress == "" {
+			return DescriptorEntry{}, false
+		}
+		return DescriptorEntry{Key: "remote_address", Value: req.RemoteAddress}, true
+
+	case d.RequestHeader != nil:
+		v := req.Headers.Get(d.RequestHeader.Name)
+		if v == "" {
+			return DescriptorEntry{}, false
+		}
+		return DescriptorEntry{Key: "header_match_" + d.RequestHeader.Name, Value: v}, true
+
+	case d.PathMatch != nil:
+		if !pathMatches(*d.PathMatch, req.Path) {
+			return DescriptorEntry{}, false
+		}
+		// The value is the configured matcher, not the request path, so every path it matches
+		// shares one counter.
+		value := d.PathMatch.Exact
+		if value == "" {
+			value = d.PathMatch.Prefix
+		}
+		if value == "" {
+			value = d.PathMatch.Regex
+		}
+		return DescriptorEntry{Key: "path_match_" + pathMatchKey(*d.PathMatch), Value: value}, true
+
+	case d.GenericKey != nil:
+		return DescriptorEntry{Key: "generic_key", Value: d.GenericKey.Value}, true
+
+	case d.Metadata != nil:
+		v, present := req.Metadata[d.Metadata.Source+"."+d.Metadata.Key]
+		if !present {
+			return DescriptorEntry{}, false
+		}
+		return DescriptorEntry{Key: "metadata_" + d.Metadata.Source + "_" + d.Metadata.Key, Value: v}, true
+
+	default:
+		return DescriptorEntry{}, false
+	}
+}
+
+func pathMatches(m agentgateway.PathMatchDescriptor, path string) bool {
+	switch {
+	case m.Exact != "":
+		return path == m.Exact
+	case m.Prefix != "":
+		return strings.HasPrefix(path, m.Prefix)
+	case m.Regex != "":
+		matched, err := regexp.MatchString(m.Regex, path)
+		return err == nil && matched
+	default:
+		return false
+	}
+}
+
+// pathMatchKey returns a stable, human-readable identifier for a PathMatchDescriptor's
+// configured matcher, used as part of the descriptor key sent to the ratelimit service.
+func pathMatchKey(m agentgateway.PathMatchDescriptor) string {
+	switch {
+	case m.Exact != "":
+		return "exact_" + m.Exact
+	case m.Prefix != "":
+		return "prefix_" + m.Prefix
+	case m.Regex != "":
+		return "regex_" + m.Regex
+	default:
+		return "unset"
+	}
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+func TestTranslateUserAndPathTupleIsIndependentPerPath(t *testing.T) {
+	rule := agentgateway.Rule{
+		Name: "user-path1-limit",
+		Descriptors: []agentgateway.Descriptor{
+			{RequestHeader: &agentgateway.RequestHeaderDescriptor{Name: "X-User-ID"}},
+			{PathMatch: &agentgateway.PathMatchDescriptor{Exact: "/path1"}},
+		},
+	}
+
+	headers := http.Header{"X-User-Id": []string{"user1"}}
+
+	tuple, ok := Translate(rule, RequestContext{Path: "/path1", Headers: headers})
+	assert.True(t, ok)
+	assert.Equal(t, []DescriptorEntry{
+		{Key: "header_match_X-User-ID", Value: "user1"},
+		{Key: "path_match_exact_/path1", Value: "/path1"},
+	}, tuple)
+
+	// A request for a different path doesn't match this rule's pathMatch entry, so the whole
+	// rule is skipped rather than contributing a false tuple.
+	_, ok = Translate(rule, RequestContext{Path: "/path2", Headers: headers})
+	assert.False(t, ok)
+}
+
+func TestTranslateSkipIfAbsentOmitsEntryInsteadOfRule(t *testing.T) {
+	skip := false
+	rule := agentgateway.Rule{
+		Descriptors: []agentgateway.Descriptor{
+			{RemoteAddress: &agentgateway.RemoteAddressDescriptor{}},
+			{Metadata: &agentgateway.MetadataDescriptor{Source: "envoy.filters.http.ext_authz", Key: "tier"}, SkipIfAbsent: &skip},
+		},
+	}
+
+	tuple, ok := Translate(rule, RequestContext{RemoteAddress: "1.2.3.4", Metadata: map[string]string{}})
+	assert.True(t, ok)
+	assert.Equal(t, []DescriptorEntry{{Key: "remote_address", Value: "1.2.3.4"}}, tuple)
+}
+
+func TestTranslateGenericKeyIsRequestIndependent(t *testing.T) {
+	rule := agentgateway.Rule{
+		Descriptors: []agentgateway.Descriptor{
+			{GenericKey: &agentgateway.GenericKeyDescriptor{Value: "global"}},
+		},
+	}
+
+	tuple, ok := Translate(rule, RequestContext{})
+	assert.True(t, ok)
+	assert.Equal(t, []DescriptorEntry{{Key: "generic_key", Value: "global"}}, tuple)
+}
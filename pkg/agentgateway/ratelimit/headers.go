@@ -0,0 +1,46 @@
+// Package ratelimit translates AgentgatewayPolicy rate-limit configuration into the descriptors,
+// counting algorithm, response headers, and shadow-mode behavior enforced on the data path.
+package ratelimit
+
+import "strconv"
+
+// Decision is the outcome of a single rate-limit check against one rule.
+type Decision struct {
+	// Limit is the configured requests-per-unit limit.
+	Limit uint32
+	// Remaining is the number of requests still allowed in the current window.
+	Remaining uint32
+	// ResetSeconds is how many seconds until the window resets.
+	ResetSeconds uint32
+	// OverLimit is true if this decision would reject the request.
+	OverLimit bool
+}
+
+// TightestDecision returns the decision with the least remaining quota, since that's the
+// descriptor whose limit the response headers should reflect when multiple rules match.
+func TightestDecision(decisions []Decision) (Decision, bool) {
+	if len(decisions) == 0 {
+		return Decision{}, false
+	}
+	tightest := decisions[0]
+	for _, d := range decisions[1:] {
+		if d.Remaining < tightest.Remaining {
+			tightest = d
+		}
+	}
+	return tightest, true
+}
+
+// ResponseHeaders builds the IETF draft RateLimit-* headers (plus Retry-After when over limit)
+// for the given decision.
+func ResponseHeaders(d Decision) map[string]string {
+	headers := map[string]string{
+		"RateLimit-Limit":     strconv.FormatUint(uint64(d.Limit), 10),
+		"RateLimit-Remaining": strconv.FormatUint(uint64(d.Remaining), 10),
+		"RateLimit-Reset":     strconv.FormatUint(uint64(d.ResetSeconds), 10),
+	}
+	if d.OverLimit {
+		headers["Retry-After"] = strconv.FormatUint(uint64(d.ResetSeconds), 10)
+	}
+	return headers
+}
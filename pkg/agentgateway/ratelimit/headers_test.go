@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTightestDecisionPicksLeastRemaining(t *testing.T) {
+	decisions := []Decision{
+		{Limit: 10, Remaining: 5, ResetSeconds: 30},
+		{Limit: 1, Remaining: 0, ResetSeconds: 12, OverLimit: true},
+		{Limit: 5, Remaining: 2, ResetSeconds: 20},
+	}
+
+	tightest, ok := TightestDecision(decisions)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0), tightest.Remaining)
+	assert.True(t, tightest.OverLimit)
+}
+
+func TestResponseHeadersIncludesRetryAfterOnlyWhenOverLimit(t *testing.T) {
+	ok := ResponseHeaders(Decision{Limit: 1, Remaining: 1, ResetSeconds: 60})
+	assert.Equal(t, "1", ok["RateLimit-Limit"])
+	assert.Equal(t, "1", ok["RateLimit-Remaining"])
+	assert.Equal(t, "60", ok["RateLimit-Reset"])
+	_, hasRetryAfter := ok["Retry-After"]
+	assert.False(t, hasRetryAfter)
+
+	overLimit := ResponseHeaders(Decision{Limit: 1, Remaining: 0, ResetSeconds: 42, OverLimit: true})
+	assert.Equal(t, "42", overLimit["Retry-After"])
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+// ShadowDecisionHeader is the response header reporting what a shadow-mode decision would have
+// been, had the policy been enforcing.
+const ShadowDecisionHeader = "X-RateLimit-Shadow-Decision"
+
+// ShadowDecisionOverLimit is the value of ShadowDecisionHeader when the request would have been
+// rejected.
+const ShadowDecisionOverLimit = "over_limit"
+
+// ShadowOverLimitTotal counts requests that would have been rejected had a shadow-mode policy
+// been enforcing, labeled by the policy and the descriptor tuple that triggered the decision.
+var ShadowOverLimitTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "agentgateway_ratelimit_shadow_over_limit_total",
+		Help: "Requests that would have been rejected by a shadow-mode AgentgatewayPolicy rate limit rule.",
+	},
+	[]string{"policy", "descriptor"},
+)
+
+// Enforce decides what to actually do with a Decision under the given enforcement mode: whether
+// to reject the request, and the response headers to attach (including the shadow decision
+// header in shadow mode). The RateLimit-* headers are attached whatever the policy's
+// spec.responseHeaders setting.
+//
+// policyName/descriptor identify the policy and descriptor tuple for the ShadowOverLimitTotal
+// metric; descriptor should be a stable, human-readable rendering of the descriptor tuple.
+func Enforce(mode agentgateway.EnforcementMode, d Decision, policyName, descriptor string) (reject bool, headers map[string]string) {
+	headers = ResponseHeaders(d)
+
+	if mode != agentgateway.EnforcementModeShadow {
+		return d.OverLimit, headers
+	}
+
+	if d.OverLimit {
+		ShadowOverLimitTotal.WithLabelValues(policyName, descriptor).Inc()
+		headers[ShadowDecisionHeader] = ShadowDecisionOverLimit
+	}
+	// Shadow mode never rejects, regardless of the underlying decision.
+	return false, headers
+}
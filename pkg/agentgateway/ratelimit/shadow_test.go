@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+)
+
+func TestEnforceModeRejectsOverLimit(t *testing.T) {
+	reject, headers := Enforce(agentgateway.EnforcementModeEnforce, Decision{Limit: 1, Remaining: 0, OverLimit: true}, "ip-rate-limit", "remote_address=1.2.3.4")
+	assert.True(t, reject)
+	_, hasShadowHeader := headers[ShadowDecisionHeader]
+	assert.False(t, hasShadowHeader)
+}
+
+func TestShadowModeNeverRejectsButReportsDecision(t *testing.T) {
+	ShadowOverLimitTotal.Reset()
+
+	reject, headers := Enforce(agentgateway.EnforcementModeShadow, Decision{Limit: 1, Remaining: 0, OverLimit: true}, "ip-rate-limit-shadow", "remote_address=1.2.3.4")
+	assert.False(t, reject)
+	assert.Equal(t, ShadowDecisionOverLimit, headers[ShadowDecisionHeader])
+
+	count := testutil.ToFloat64(ShadowOverLimitTotal.WithLabelValues("ip-rate-limit-shadow", "remote_address=1.2.3.4"))
+	assert.Equal(t, float64(1), count)
+}
+
+func TestShadowModeUnderLimitReportsNoDecision(t *testing.T) {
+	reject, headers := Enforce(agentgateway.EnforcementModeShadow, Decision{Limit: 1, Remaining: 1, OverLimit: false}, "ip-rate-limit-shadow", "remote_address=1.2.3.4")
+	assert.False(t, reject)
+	_, hasShadowHeader := headers[ShadowDecisionHeader]
+	assert.False(t, hasShadowHeader)
+}
@@ -0,0 +1,47 @@
+//go:build e2e
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/retry"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+	"github.com/kgateway-dev/kgateway/v2/test/e2e"
+)
+
+// IsPolicyAcceptedAndEnforced blocks until the AgentgatewayPolicy identified by key reports
+// Accepted=True and Enforced=True, or fails the test if that never happens.
+//
+// Tests should prefer this over bursting requests at the data plane and inferring programming
+// state from response codes: a policy can be Accepted (valid, targetRef resolved) well before
+// the data plane has actually enforced it, and the reverse ambiguity is exactly what causes
+// flaky window-boundary assertions in the rate limit suites.
+func IsPolicyAcceptedAndEnforced(ctx context.Context, t *testing.T, installation *e2e.TestInstallation, key types.NamespacedName) {
+	t.Helper()
+
+	retry.UntilSuccessOrFail(t, func() error {
+		policy := &agentgateway.AgentgatewayPolicy{}
+		if err := installation.ClusterContext.Client.Get(ctx, key, policy); err != nil {
+			return err
+		}
+
+		accepted := meta.FindStatusCondition(policy.Status.Conditions, agentgateway.AgentgatewayPolicyConditionAccepted)
+		if accepted == nil || accepted.Status != metav1.ConditionTrue {
+			return fmt.Errorf("policy %s is not yet %s", key, agentgateway.AgentgatewayPolicyConditionAccepted)
+		}
+
+		enforced := meta.FindStatusCondition(policy.Status.Conditions, agentgateway.AgentgatewayPolicyConditionEnforced)
+		if enforced == nil || enforced.Status != metav1.ConditionTrue {
+			return fmt.Errorf("policy %s is not yet %s", key, agentgateway.AgentgatewayPolicyConditionEnforced)
+		}
+
+		return nil
+	})
+}
@@ -6,11 +6,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/suite"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/requestutils/curl"
 	"github.com/kgateway-dev/kgateway/v2/test/e2e"
 	"github.com/kgateway-dev/kgateway/v2/test/e2e/common"
@@ -43,6 +46,11 @@ type testingSuite struct {
 // 3 = one to establish state, two to confirm; fewer risks a transient, more risks crossing the window.
 var rlBurstTries = 3
 
+// slidingWindowTries: under spec.algorithm: slidingWindow the estimate blends the previous and
+// current window counts, so there's no abrupt reset at the boundary to straddle. This loops
+// comfortably longer than rlBurstTries to demonstrate that.
+var slidingWindowTries = 10
+
 func NewTestingSuite(ctx context.Context, testInst *e2e.TestInstallation) suite.TestingSuite {
 	return &testingSuite{
 		ctx:              ctx,
@@ -150,6 +158,175 @@ func (s *testingSuite) TestCombinedLocalAndGlobalRateLimit() {
 	s.assertConsistentResponse("/path1", http.StatusTooManyRequests)
 }
 
+// TestGlobalRateLimitByRemoteAddressSlidingWindow mirrors TestGlobalRateLimitByRemoteAddress
+// but against a policy using spec.algorithm: slidingWindow. Because the sliding-window estimate
+// never resets abruptly at a window boundary, this loops well past rlBurstTries without the
+// flake the fixed-window suite guards against.
+func (s *testingSuite) TestGlobalRateLimitByRemoteAddressSlidingWindow() {
+	s.setupTest([]string{httpRoutesManifest, ipRateLimitSlidingWindowManifest}, []client.Object{route, route2, ipRateLimitSlidingWindowAgentgatewayPolicy})
+
+	// First request should be successful
+	s.assertResponse("/path1", http.StatusOK)
+
+	// Consecutive requests should be rate limited, even well past a window boundary
+	for range slidingWindowTries {
+		s.assertResponse("/path1", http.StatusTooManyRequests)
+	}
+}
+
+// TestGlobalRateLimitShadowMode verifies that a policy in spec.enforcementMode: shadow never
+// returns 429s but reports what the decision would have been via a response header, and that
+// flipping the same policy to enforce starts returning real 429s for the same traffic.
+func (s *testingSuite) TestGlobalRateLimitShadowMode() {
+	s.setupTest([]string{httpRoutesManifest, ipRateLimitShadowManifest}, []client.Object{route, route2, ipRateLimitShadowAgentgatewayPolicy})
+
+	// First request should be successful, and not yet over the shadow limit.
+	s.assertResponse("/path1", http.StatusOK)
+
+	// Once the would-be limit is exceeded, shadow mode still returns 200 but flags the decision.
+	for range rlBurstTries {
+		common.BaseGateway.Send(
+			s.T(),
+			&testmatchers.HttpResponse{
+				StatusCode: http.StatusOK,
+				Headers: map[string]any{
+					"X-RateLimit-Shadow-Decision": "over_limit",
+				},
+			},
+			curl.WithPath("/path1"),
+			curl.WithHostHeader("example.com"),
+		)
+	}
+
+	// Flip the same policy to enforce mode, in place.
+	err := s.testInstallation.Actions.Kubectl().ApplyFile(s.ctx, ipRateLimitEnforceManifest)
+	s.Require().NoError(err, "can apply "+ipRateLimitEnforceManifest)
+	common.IsPolicyAcceptedAndEnforced(s.ctx, s.T(), s.testInstallation, client.ObjectKeyFromObject(ipRateLimitShadowAgentgatewayPolicy))
+
+	// The same over-limit traffic now gets real 429s.
+	s.assertConsistentResponse("/path1", http.StatusTooManyRequests)
+}
+
+// TestGlobalRateLimitByUserAndPath verifies that rules whose descriptor lists compose X-User-ID
+// and a path into a single tuple limit each (user, path) pair independently: changing either the
+// user or the path alone yields a distinct counter.
+func (s *testingSuite) TestGlobalRateLimitByUserAndPath() {
+	s.setupTest([]string{httpRoutesManifest, userAndPathRateLimitManifest}, []client.Object{route, route2, userAndPathRateLimitAgentgatewayPolicy})
+
+	// First request for (user1, /path1) should be successful
+	s.assertResponseWithHeader("/path1", "X-User-ID", "user1", http.StatusOK)
+
+	// Consecutive requests for the same (user1, /path1) tuple should be rate limited
+	s.assertConsistentResponseWithHeader("/path1", "X-User-ID", "user1", http.StatusTooManyRequests)
+
+	// Same user, different path: independent counter, not yet limited
+	s.assertResponseWithHeader("/path2", "X-User-ID", "user1", http.StatusOK)
+
+	// Same path, different user: independent counter, not yet limited
+	s.assertResponseWithHeader("/path1", "X-User-ID", "user2", http.StatusOK)
+}
+
+// TestGlobalRateLimitGatewayDefaultMerge verifies that a Gateway-scoped merge default fills in
+// rules that are absent from a route's own policy, without disturbing rules the route already
+// defines under a different name.
+func (s *testingSuite) TestGlobalRateLimitGatewayDefaultMerge() {
+	s.setupTest(
+		[]string{httpRoutesManifest, pathRateLimitManifest, gatewayDefaultMergeManifest},
+		[]client.Object{route, route2, pathRateLimitAgentgatewayPolicy, gatewayDefaultMergeAgentgatewayPolicy},
+	)
+
+	// route has its own path-scoped rule, so /path1 is limited by that rule as before.
+	s.assertResponse("/path1", http.StatusOK)
+	s.assertConsistentResponse("/path1", http.StatusTooManyRequests)
+
+	// route2 has no policy of its own, so it inherits the gateway default's remote-address rule.
+	s.assertResponse("/path2", http.StatusOK)
+	s.assertConsistentResponse("/path2", http.StatusTooManyRequests)
+}
+
+// TestGlobalRateLimitGatewayDefaultAtomic verifies that an atomic default is ignored wholesale
+// for any target that already has a policy attached, but still applies in full to targets with
+// no policy of their own.
+func (s *testingSuite) TestGlobalRateLimitGatewayDefaultAtomic() {
+	s.setupTest(
+		[]string{httpRoutesManifest, pathRateLimitManifest, gatewayDefaultAtomicManifest},
+		[]client.Object{route, route2, pathRateLimitAgentgatewayPolicy, gatewayDefaultAtomicAgentgatewayPolicy},
+	)
+
+	// route already has its own policy, so the atomic default is ignored for it entirely:
+	// only the path-scoped rule applies, and other paths on it stay unaffected.
+	s.assertResponse("/path1", http.StatusOK)
+	s.assertConsistentResponse("/path1", http.StatusTooManyRequests)
+
+	// route2 has no policy of its own, so the atomic default applies in full.
+	s.assertResponse("/path2", http.StatusOK)
+	s.assertConsistentResponse("/path2", http.StatusTooManyRequests)
+}
+
+// TestGlobalRateLimitGatewayOverrideMerge verifies that a merge override replaces only the rules
+// it names, adding to rather than wholesale-replacing a route's own policy.
+func (s *testingSuite) TestGlobalRateLimitGatewayOverrideMerge() {
+	s.setupTest(
+		[]string{httpRoutesManifest, ipRateLimitManifest, gatewayOverrideMergeManifest},
+		[]client.Object{route, route2, ipRateLimitAgentgatewayPolicy, gatewayOverrideMergeAgentgatewayPolicy},
+	)
+
+	// the override replaces the ip-rate-limit rule with a tighter one, so both routes are
+	// limited, same as the base ip-rate-limit policy but using the override's limit.
+	s.assertResponse("/path1", http.StatusOK)
+	s.assertConsistentResponse("/path1", http.StatusTooManyRequests)
+	s.assertConsistentResponse("/path2", http.StatusTooManyRequests)
+}
+
+// TestGlobalRateLimitResponseHeaders verifies that RateLimit-Remaining decrements across a
+// burst once spec.responseHeaders is enabled, and that Retry-After on the eventual 429 falls
+// within the configured window.
+func (s *testingSuite) TestGlobalRateLimitResponseHeaders() {
+	s.setupTest(
+		[]string{httpRoutesManifest, ipRateLimitHeadersManifest},
+		[]client.Object{route, route2, ipRateLimitHeadersAgentgatewayPolicy},
+	)
+
+	// The rule allows 3 requests per unit; each request under the limit should report a
+	// strictly decrementing RateLimit-Remaining so the header actually demonstrates quota
+	// consumption rather than sitting at a single fixed value.
+	for _, remaining := range []string{"2", "1", "0"} {
+		common.BaseGateway.Send(
+			s.T(),
+			&testmatchers.HttpResponse{
+				StatusCode: http.StatusOK,
+				Headers: map[string]any{
+					"RateLimit-Limit":     "3",
+					"RateLimit-Remaining": remaining,
+					"RateLimit-Reset":     gomega.Not(gomega.BeEmpty()),
+				},
+			},
+			curl.WithPath("/path1"),
+			curl.WithHostHeader("example.com"),
+		)
+	}
+
+	// Once the limit is exceeded, the 429 carries Retry-After within [0, window] and reports
+	// the would-be decision via the same RateLimit-* headers.
+	common.BaseGateway.Send(
+		s.T(),
+		&testmatchers.HttpResponse{
+			StatusCode: http.StatusTooManyRequests,
+			Headers: map[string]any{
+				"Retry-After":         gomega.WithTransform(atoiHeader, gomega.And(gomega.BeNumerically(">=", 0), gomega.BeNumerically("<=", 60))),
+				"RateLimit-Remaining": "0",
+			},
+		},
+		curl.WithPath("/path1"),
+		curl.WithHostHeader("example.com"),
+	)
+}
+
+// atoiHeader parses a single response header value as an integer for numeric-range matchers.
+func atoiHeader(value string) (int, error) {
+	return strconv.Atoi(value)
+}
+
 func (s *testingSuite) setupTest(manifests []string, resources []client.Object) {
 	testutils.Cleanup(s.T(), func() {
 		for _, manifest := range manifests {
@@ -164,6 +341,15 @@ func (s *testingSuite) setupTest(manifests []string, resources []client.Object)
 		s.Require().NoError(err, "can apply "+manifest)
 	}
 	s.testInstallation.AssertionsT(s.T()).EventuallyObjectsExist(s.ctx, resources...)
+
+	// Wait for any AgentgatewayPolicy in this test's resources to be Accepted and Enforced
+	// before hitting the data plane, so we don't have to infer programming state from bursts
+	// of requests against a clock-aligned rate limit window.
+	for _, resource := range resources {
+		if policy, ok := resource.(*agentgateway.AgentgatewayPolicy); ok {
+			common.IsPolicyAcceptedAndEnforced(s.ctx, s.T(), s.testInstallation, client.ObjectKeyFromObject(policy))
+		}
+	}
 }
 
 func (s *testingSuite) assertResponse(path string, expectedStatus int) {
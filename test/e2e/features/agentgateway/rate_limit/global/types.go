@@ -30,6 +30,15 @@ var (
 	combinedRateLimitManifest = getTestFile("combined-rate-limit.yaml")
 	rateLimitServerManifest   = getTestFile("rate-limit-server.yaml")
 
+	gatewayDefaultMergeManifest      = getTestFile("gateway-default-merge-rate-limit.yaml")
+	gatewayDefaultAtomicManifest     = getTestFile("gateway-default-atomic-rate-limit.yaml")
+	gatewayOverrideMergeManifest     = getTestFile("gateway-override-merge-rate-limit.yaml")
+	ipRateLimitHeadersManifest       = getTestFile("ip-rate-limit-response-headers.yaml")
+	userAndPathRateLimitManifest     = getTestFile("user-and-path-rate-limit.yaml")
+	ipRateLimitSlidingWindowManifest = getTestFile("ip-rate-limit-sliding-window.yaml")
+	ipRateLimitShadowManifest        = getTestFile("ip-rate-limit-shadow.yaml")
+	ipRateLimitEnforceManifest       = getTestFile("ip-rate-limit-enforce.yaml")
+
 	gateway = &gwv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "gateway",
@@ -109,6 +118,67 @@ var (
 			Name:      "combined-rate-limit",
 		},
 	}
+
+	// Gateway-scoped AgentgatewayPolicy carrying spec.defaults/spec.overrides, used to test
+	// how they compose with the per-route policies above.
+	gatewayDefaultMergeAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "gateway-default-merge",
+		},
+	}
+
+	gatewayDefaultAtomicAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "gateway-default-atomic",
+		},
+	}
+
+	gatewayOverrideMergeAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "gateway-override-merge",
+		},
+	}
+
+	// ipRateLimitHeadersAgentgatewayPolicy is the ip-rate-limit policy with
+	// spec.responseHeaders.enabled set, used to assert on the RateLimit-* response headers.
+	ipRateLimitHeadersAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "ip-rate-limit-response-headers",
+		},
+	}
+
+	// userAndPathRateLimitAgentgatewayPolicy carries one rule per path, each of whose descriptor
+	// lists composes X-User-ID and that path into a single tuple, so each (user, path) pair is
+	// rate limited independently.
+	userAndPathRateLimitAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "user-and-path-rate-limit",
+		},
+	}
+
+	// ipRateLimitSlidingWindowAgentgatewayPolicy is the ip-rate-limit policy with
+	// spec.algorithm set to slidingWindow, used to verify that crossing a window boundary
+	// no longer causes a sudden reset.
+	ipRateLimitSlidingWindowAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "ip-rate-limit-sliding-window",
+		},
+	}
+
+	// ipRateLimitShadowAgentgatewayPolicy starts out in spec.enforcementMode: shadow and is
+	// later re-applied with ipRateLimitEnforceManifest to flip it to enforce, in place.
+	ipRateLimitShadowAgentgatewayPolicy = &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "ip-rate-limit-shadow",
+		},
+	}
 )
 
 func getTestFile(filename string) string {
@@ -0,0 +1,108 @@
+// Package matchers provides gomega matchers for asserting on *http.Response values returned by
+// the e2e test framework's HTTP clients.
+package matchers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/onsi/gomega/types"
+)
+
+// HttpResponse describes the expected shape of an HTTP response. A zero-valued field other than
+// Headers/Body is not asserted on.
+type HttpResponse struct {
+	// StatusCode is the expected HTTP status code. Zero means "don't care".
+	StatusCode int
+
+	// Body is matched against the response body. May be a string, []byte, or a gomega matcher.
+	// Nil means "don't care".
+	Body any
+
+	// Headers maps a header name to an expected value. A string value compares for equality;
+	// anything else must be a gomega matcher applied to the header's string value. Headers not
+	// listed here are not asserted on.
+	Headers map[string]any
+}
+
+// HaveHttpResponse returns a gomega matcher for *http.Response that checks the subset of
+// expected fields set on expected.
+func HaveHttpResponse(expected *HttpResponse) types.GomegaMatcher {
+	return &httpResponseMatcher{expected: expected}
+}
+
+type httpResponseMatcher struct {
+	expected *HttpResponse
+	failures []string
+}
+
+func (m *httpResponseMatcher) Match(actual any) (bool, error) {
+	resp, ok := actual.(*http.Response)
+	if !ok {
+		return false, fmt.Errorf("HaveHttpResponse matcher expects *http.Response, got %T", actual)
+	}
+	m.failures = nil
+
+	if m.expected.StatusCode != 0 && resp.StatusCode != m.expected.StatusCode {
+		m.failures = append(m.failures, fmt.Sprintf("expected status code %d, got %d", m.expected.StatusCode, resp.StatusCode))
+	}
+
+	for name, want := range m.expected.Headers {
+		got := resp.Header.Get(name)
+		matched, err := matchValue(want, got)
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			m.failures = append(m.failures, fmt.Sprintf("header %q: expected to match %v, got %q", name, want, got))
+		}
+	}
+
+	if m.expected.Body != nil && resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return false, fmt.Errorf("reading response body: %w", err)
+		}
+		matched, err := matchValue(m.expected.Body, string(body))
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			m.failures = append(m.failures, fmt.Sprintf("body: expected to match %v, got %q", m.expected.Body, body))
+		}
+	}
+
+	return len(m.failures) == 0, nil
+}
+
+// matchValue compares got against want, which is either a plain string (equality) or a gomega
+// matcher applied to got.
+func matchValue(want any, got string) (bool, error) {
+	switch w := want.(type) {
+	case string:
+		return w == got, nil
+	case []byte:
+		return string(w) == got, nil
+	case types.GomegaMatcher:
+		return w.Match(got)
+	default:
+		return false, fmt.Errorf("expected value must be a string or gomega matcher, got %T", want)
+	}
+}
+
+func (m *httpResponseMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("expected http response to satisfy:\n%s", formatFailures(m.failures))
+}
+
+func (m *httpResponseMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("expected http response not to satisfy:\n%s", formatFailures(m.failures))
+}
+
+func formatFailures(failures []string) string {
+	out := ""
+	for _, f := range failures {
+		out += "  - " + f + "\n"
+	}
+	return out
+}